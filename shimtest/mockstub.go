@@ -166,6 +166,26 @@ func (stub *MockStub) MockInvokeWithSignedProposal(uuid string, args [][]byte, s
 	return res
 }
 
+// MockInvocation is a single function call with its arguments, used to
+// describe one step of a scenario passed to MockInvokeBatch.
+type MockInvocation struct {
+	Function string
+	Args     []string
+}
+
+// Invoke this chaincode once for each invocation, in order, against the same
+// state. Each invocation starts and ends its own transaction, with a uuid made
+// from uuidPrefix and the invocation's index. The responses are returned in
+// the same order as the invocations, whatever their status.
+func (stub *MockStub) MockInvokeBatch(uuidPrefix string, invocations []MockInvocation) []pb.Response {
+	responses := make([]pb.Response, 0, len(invocations))
+	for i, invocation := range invocations {
+		uuid := fmt.Sprintf("%s%d", uuidPrefix, i)
+		responses = append(responses, stub.MockInvoke(uuid, getBytes(invocation.Function, invocation.Args)))
+	}
+	return responses
+}
+
 func (stub *MockStub) GetPrivateData(collection string, key string) ([]byte, error) {
 	m, in := stub.PvtState[collection]
 
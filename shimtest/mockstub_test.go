@@ -11,6 +11,7 @@ import (
 
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 	"github.com/hyperledger/fabric-chaincode-go/shimtest/mock"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -250,6 +251,52 @@ func TestPutEmptyState(t *testing.T) {
 
 }
 
+// TestMockInvokeBatch runs a create, read, update, read scenario and checks
+// each step sees the state left by the previous one.
+func TestMockInvokeBatch(t *testing.T) {
+	cc := &mock.Chaincode{}
+	var txIDs []string
+	cc.InvokeCalls(func(stub shim.ChaincodeStubInterface) pb.Response {
+		txIDs = append(txIDs, stub.GetTxID())
+		function, args := stub.GetFunctionAndParameters()
+		switch function {
+		case "put":
+			if err := stub.PutState(args[0], []byte(args[1])); err != nil {
+				return shim.Error(err.Error())
+			}
+			return shim.Success(nil)
+		case "get":
+			value, err := stub.GetState(args[0])
+			if err != nil {
+				return shim.Error(err.Error())
+			}
+			return shim.Success(value)
+		}
+		return shim.Error("unknown function " + function)
+	})
+
+	stub := NewMockStub("batch", cc)
+	responses := stub.MockInvokeBatch("tx", []MockInvocation{
+		{Function: "put", Args: []string{"asset1", "red"}},
+		{Function: "get", Args: []string{"asset1"}},
+		{Function: "put", Args: []string{"asset1", "blue"}},
+		{Function: "get", Args: []string{"asset1"}},
+		{Function: "delete", Args: []string{"asset1"}},
+	})
+
+	assert.Len(t, responses, 5)
+	assert.Equal(t, int32(shim.OK), responses[0].Status)
+	assert.Equal(t, []byte("red"), responses[1].Payload)
+	assert.Equal(t, int32(shim.OK), responses[2].Status)
+	assert.Equal(t, []byte("blue"), responses[3].Payload)
+	assert.Equal(t, int32(shim.ERROR), responses[4].Status)
+	assert.Equal(t, "unknown function delete", responses[4].Message)
+	assert.Equal(t, []string{"tx0", "tx1", "tx2", "tx3", "tx4"}, txIDs)
+	assert.Equal(t, "", stub.TxID, "transaction should be ended after the batch")
+
+	assert.Empty(t, stub.MockInvokeBatch("tx", nil))
+}
+
 // TestMockMock clearly cheating for coverage... but not. Mock should
 // be tucked away under common/mocks package which is not
 // included for coverage. Moving mockstub to another package